@@ -0,0 +1,68 @@
+package main
+
+/*
+#include <stdlib.h>
+*/
+import "C"
+
+import "unsafe"
+
+//export bitwise_and_arr_go
+func bitwise_and_arr_go(a, b, out *C.int, size C.int) {
+	runChunked(int(size), func(start, end int) {
+		for i := start; i < end; i++ {
+			elemPtr(out, i).setFrom(*elemPtr(a, i).p & *elemPtr(b, i).p)
+		}
+	})
+}
+
+//export bitwise_or_arr_go
+func bitwise_or_arr_go(a, b, out *C.int, size C.int) {
+	runChunked(int(size), func(start, end int) {
+		for i := start; i < end; i++ {
+			elemPtr(out, i).setFrom(*elemPtr(a, i).p | *elemPtr(b, i).p)
+		}
+	})
+}
+
+//export bitwise_xor_arr_go
+func bitwise_xor_arr_go(a, b, out *C.int, size C.int) {
+	runChunked(int(size), func(start, end int) {
+		for i := start; i < end; i++ {
+			elemPtr(out, i).setFrom(*elemPtr(a, i).p ^ *elemPtr(b, i).p)
+		}
+	})
+}
+
+func (e elem) setFrom(v C.int) { *e.p = v }
+
+// alloc_result allocates size*elem_size bytes on the C heap (not tracked by
+// Go's GC) so the returned pointer can be handed straight to Python's
+// ctypes without the caller pre-sizing an output buffer. The caller owns
+// the result and MUST release it with free_result; letting it leak is a
+// permanent C-heap leak, and calling free_result twice is a double free.
+//
+// Returns NULL if size or elem_size is negative, or if their product would
+// overflow size_t, rather than silently handing back a too-small buffer.
+//
+//export alloc_result
+func alloc_result(size C.int, elem_size C.int) unsafe.Pointer {
+	if size < 0 || elem_size < 0 {
+		return nil
+	}
+	n, es := C.size_t(size), C.size_t(elem_size)
+	total := n * es
+	if es != 0 && total/es != n {
+		return nil
+	}
+	return C.malloc(total)
+}
+
+// free_result releases a buffer previously returned by alloc_result. It is
+// the only valid way to release that memory; do not pass it to Python's
+// garbage collector or any other allocator.
+//
+//export free_result
+func free_result(ptr unsafe.Pointer) {
+	C.free(ptr)
+}
@@ -0,0 +1,95 @@
+package main
+
+/*
+#include <stdint.h>
+*/
+import "C"
+
+import (
+	"math/bits"
+	"sync"
+	"sync/atomic"
+)
+
+// popcount_go writes bits.OnesCount32 of each element of data into out.
+//
+//export popcount_go
+func popcount_go(data *C.int, size C.int, out *C.int) {
+	runChunked(int(size), func(start, end int) {
+		for i := start; i < end; i++ {
+			v := uint32(*elemPtr(data, i).p)
+			*elemPtr(out, i).p = C.int(bits.OnesCount32(v))
+		}
+	})
+}
+
+// clz_go writes bits.LeadingZeros32 of each element of data into out.
+//
+//export clz_go
+func clz_go(data *C.int, size C.int, out *C.int) {
+	runChunked(int(size), func(start, end int) {
+		for i := start; i < end; i++ {
+			v := uint32(*elemPtr(data, i).p)
+			*elemPtr(out, i).p = C.int(bits.LeadingZeros32(v))
+		}
+	})
+}
+
+// ctz_go writes bits.TrailingZeros32 of each element of data into out.
+//
+//export ctz_go
+func ctz_go(data *C.int, size C.int, out *C.int) {
+	runChunked(int(size), func(start, end int) {
+		for i := start; i < end; i++ {
+			v := uint32(*elemPtr(data, i).p)
+			*elemPtr(out, i).p = C.int(bits.TrailingZeros32(v))
+		}
+	})
+}
+
+// bit_reverse_go writes bits.Reverse32 of each element of data into out.
+//
+//export bit_reverse_go
+func bit_reverse_go(data *C.int, size C.int, out *C.int) {
+	runChunked(int(size), func(start, end int) {
+		for i := start; i < end; i++ {
+			v := uint32(*elemPtr(data, i).p)
+			*elemPtr(out, i).p = C.int(bits.Reverse32(v))
+		}
+	})
+}
+
+// popcount_total_go sums bits.OnesCount32 across every element of data -
+// the total population count, useful for bitmap/bloom-filter cardinality
+// without pulling the per-element counts back into Python first.
+//
+//export popcount_total_go
+func popcount_total_go(data *C.int, size C.int) C.uint64_t {
+	var total uint64
+	runChunked(int(size), func(start, end int) {
+		var partial uint64
+		for i := start; i < end; i++ {
+			partial += uint64(bits.OnesCount32(uint32(*elemPtr(data, i).p)))
+		}
+		atomic.AddUint64(&total, partial)
+	})
+	return C.uint64_t(total)
+}
+
+// parity_go returns 1 if the total population count of data is odd, else 0.
+//
+//export parity_go
+func parity_go(data *C.int, size C.int) C.int {
+	var mu sync.Mutex
+	var parity uint32
+	runChunked(int(size), func(start, end int) {
+		var partial uint32
+		for i := start; i < end; i++ {
+			partial ^= uint32(bits.OnesCount32(uint32(*elemPtr(data, i).p))) & 1
+		}
+		mu.Lock()
+		parity ^= partial
+		mu.Unlock()
+	})
+	return C.int(parity)
+}
@@ -0,0 +1,140 @@
+package main
+
+/*
+#include <stdint.h>
+#include <stddef.h>
+*/
+import "C"
+
+import "unsafe"
+
+// Op enumerates the bitwise operations selectable through bitwise_op.
+const (
+	opAnd C.int = 0
+	opOr  C.int = 1
+	opXor C.int = 2
+	opNot C.int = 3
+	opShl C.int = 4
+	opShr C.int = 5
+)
+
+// opCount is the number of entries in the Op enum above.
+const opCount = 6
+
+// Dtype enumerates the element types bitwise_op can operate on, matching the
+// NumPy dtypes Python callers pass buffers in.
+const (
+	dtypeU8  C.int = 0
+	dtypeU16 C.int = 1
+	dtypeU32 C.int = 2
+	dtypeU64 C.int = 3
+	dtypeI8  C.int = 4
+	dtypeI16 C.int = 5
+	dtypeI32 C.int = 6
+	dtypeI64 C.int = 7
+)
+
+// dtypeCount is the number of entries in the Dtype enum above.
+const dtypeCount = 8
+
+// pyfunc_dtype_size reports the element width in bytes for a dtype, so the
+// Python wrapper can validate a buffer's size before calling bitwise_op.
+// Returns 0 for an unrecognized dtype.
+//
+//export pyfunc_dtype_size
+func pyfunc_dtype_size(dtype C.int) C.size_t {
+	switch dtype {
+	case dtypeU8, dtypeI8:
+		return 1
+	case dtypeU16, dtypeI16:
+		return 2
+	case dtypeU32, dtypeI32:
+		return 4
+	case dtypeU64, dtypeI64:
+		return 8
+	default:
+		return 0
+	}
+}
+
+// pyfunc_op_count reports how many operations bitwise_op recognizes, so the
+// Python wrapper can validate an op code before calling bitwise_op.
+//
+//export pyfunc_op_count
+func pyfunc_op_count() C.int {
+	return opCount
+}
+
+// bitwise_op is a single typed entry point covering every {op}x{dtype}
+// combination, so Python callers working with numpy.uint8/int64/etc. buffers
+// don't need a dedicated export per dtype. data must point to size elements
+// of the given dtype; operand is truncated to the dtype's width.
+//
+//export bitwise_op
+func bitwise_op(op C.int, dtype C.int, data unsafe.Pointer, size C.size_t, operand C.uint64_t) {
+	n := int(size)
+	// Shift counts are always unsigned, independent of the dtype: truncating
+	// operand to a signed element type (e.g. int8(200) == -56) would make
+	// opShl/opShr's s[i] <<= operand panic with "negative shift amount".
+	shift := uint(operand)
+	switch dtype {
+	case dtypeU8:
+		applyTyped(unsafe.Slice((*uint8)(data), n), op, uint8(operand), shift)
+	case dtypeU16:
+		applyTyped(unsafe.Slice((*uint16)(data), n), op, uint16(operand), shift)
+	case dtypeU32:
+		applyTyped(unsafe.Slice((*uint32)(data), n), op, uint32(operand), shift)
+	case dtypeU64:
+		applyTyped(unsafe.Slice((*uint64)(data), n), op, uint64(operand), shift)
+	case dtypeI8:
+		applyTyped(unsafe.Slice((*int8)(data), n), op, int8(operand), shift)
+	case dtypeI16:
+		applyTyped(unsafe.Slice((*int16)(data), n), op, int16(operand), shift)
+	case dtypeI32:
+		applyTyped(unsafe.Slice((*int32)(data), n), op, int32(operand), shift)
+	case dtypeI64:
+		applyTyped(unsafe.Slice((*int64)(data), n), op, int64(operand), shift)
+	}
+}
+
+// integer is satisfied by every dtype bitwise_op supports.
+type integer interface {
+	~int8 | ~int16 | ~int32 | ~int64 | ~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+// applyTyped runs op over s in place with the correctly-sized operand,
+// shared by every dtype case in bitwise_op. shift is the unsigned shift
+// count for opShl/opShr, kept separate from operand so a signed dtype
+// whose truncated operand happens to be negative can't turn into a
+// negative shift count.
+func applyTyped[T integer](s []T, op C.int, operand T, shift uint) {
+	runChunked(len(s), func(start, end int) {
+		chunk := s[start:end]
+		switch op {
+		case opAnd:
+			for i := range chunk {
+				chunk[i] &= operand
+			}
+		case opOr:
+			for i := range chunk {
+				chunk[i] |= operand
+			}
+		case opXor:
+			for i := range chunk {
+				chunk[i] ^= operand
+			}
+		case opNot:
+			for i := range chunk {
+				chunk[i] = ^chunk[i]
+			}
+		case opShl:
+			for i := range chunk {
+				chunk[i] <<= shift
+			}
+		case opShr:
+			for i := range chunk {
+				chunk[i] >>= shift
+			}
+		}
+	})
+}
@@ -0,0 +1,98 @@
+package main
+
+import "C"
+
+import (
+	"runtime"
+	"sync"
+)
+
+// parallelThreshold is the element count above which kernels fan out across
+// the worker pool instead of running inline; below it, goroutine/scheduling
+// overhead would dominate the actual work.
+const parallelThreshold = 64 * 1024
+
+var (
+	parallelMu  sync.Mutex
+	parallelism = runtime.NumCPU()
+)
+
+// chunkRange is a [start, end) slice of element indices handed to one
+// worker goroutine. Reused across calls via chunkPool to avoid a slice
+// allocation on every dispatch.
+type chunkRange struct {
+	start, end int
+}
+
+var chunkPool = sync.Pool{
+	New: func() any { return make([]chunkRange, 0, runtime.NumCPU()) },
+}
+
+// pyfunc_set_parallelism configures how many goroutines runChunked fans
+// large calls out across. Values less than 1 are ignored. Reconfiguration
+// is guarded by parallelMu, but that lock is only ever held briefly here -
+// never while a kernel is dispatching - so concurrent calls from multiple
+// Python threads are safe.
+//
+//export pyfunc_set_parallelism
+func pyfunc_set_parallelism(n C.int) {
+	if n < 1 {
+		return
+	}
+	parallelMu.Lock()
+	parallelism = int(n)
+	parallelMu.Unlock()
+}
+
+// pyfunc_get_parallelism reports the worker count runChunked currently uses.
+//
+//export pyfunc_get_parallelism
+func pyfunc_get_parallelism() C.int {
+	parallelMu.Lock()
+	n := parallelism
+	parallelMu.Unlock()
+	return C.int(n)
+}
+
+// runChunked runs fn(start, end) over [0, size). Calls below
+// parallelThreshold run fn once, inline, to keep small buffers cheap; larger
+// calls are split into up to pyfunc_get_parallelism() chunks and dispatched
+// to goroutines via a WaitGroup.
+func runChunked(size int, fn func(start, end int)) {
+	if size < parallelThreshold {
+		fn(0, size)
+		return
+	}
+
+	parallelMu.Lock()
+	workers := parallelism
+	parallelMu.Unlock()
+	if workers < 1 {
+		workers = 1
+	}
+	if workers > size {
+		workers = size
+	}
+
+	chunks := chunkPool.Get().([]chunkRange)[:0]
+	chunkSize := (size + workers - 1) / workers
+	for start := 0; start < size; start += chunkSize {
+		end := start + chunkSize
+		if end > size {
+			end = size
+		}
+		chunks = append(chunks, chunkRange{start, end})
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(len(chunks))
+	for _, c := range chunks {
+		go func(c chunkRange) {
+			defer wg.Done()
+			fn(c.start, c.end)
+		}(c)
+	}
+	wg.Wait()
+
+	chunkPool.Put(chunks)
+}
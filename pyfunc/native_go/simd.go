@@ -0,0 +1,194 @@
+package main
+
+import "C"
+
+import (
+	"sync"
+	"unsafe"
+
+	nativesimd "github.com/alexzzzs/PyFunc/pyfunc/native_go/simd"
+)
+
+// Kernel modes selectable via pyfunc_set_kernel. The zero value (scalar) is
+// always available; avx2/neon are only populated on the matching GOARCH,
+// by the init() funcs in simd_amd64.go / simd_arm64.go.
+const (
+	kernelScalar C.int = 0
+	kernelAVX2   C.int = 1
+	kernelNEON   C.int = 2
+)
+
+// wideKernel operates on whole 64-bit words (two packed C.int lanes at a
+// time); for sizes that aren't word-aligned, the bitwise_*_v callers handle
+// the trailing C.int themselves.
+type wideKernel func(data unsafe.Pointer, words int, mask uint64)
+
+// shiftKernel operates directly on 32-bit lanes since shift amount is
+// naturally per-element width.
+type shiftKernel func(data unsafe.Pointer, size int, bits uint32)
+
+var (
+	// kernelMu guards currentKernel/kernelForced. Like parallelMu in
+	// pool.go, it is only ever held for the short read/write of that
+	// state, never across a kernel dispatch - pyfunc_set_kernel can be
+	// called from one Python thread while others are mid-dispatch via
+	// bitwise_*_v, per chunk0-4's "safe to invoke concurrently" requirement.
+	kernelMu       sync.Mutex
+	currentKernel  = kernelScalar
+	kernelForced   bool
+	kernelInitOnce sync.Once
+	andKernels     [3]wideKernel
+	orKernels      [3]wideKernel
+	xorKernels     [3]wideKernel
+	notKernels     [3]wideKernel
+	leftShiftKerns [3]shiftKernel
+	rightShiftKrns [3]shiftKernel
+)
+
+func init() {
+	andKernels[kernelScalar] = nativesimd.AndWideGo
+	orKernels[kernelScalar] = nativesimd.OrWideGo
+	xorKernels[kernelScalar] = nativesimd.XorWideGo
+	notKernels[kernelScalar] = nativesimd.NotWideGo
+	leftShiftKerns[kernelScalar] = nativesimd.LeftShiftScalarGo
+	rightShiftKrns[kernelScalar] = nativesimd.RightShiftScalarGo
+}
+
+// activeKernel returns the kernel mode to dispatch to, lazily picking the
+// fastest one available the first time it's needed. This is deferred until
+// first use (rather than done in init) because the arch-specific AVX2/NEON
+// kernels register themselves from their own init() in a different file,
+// and Go does not guarantee init() order across files beyond alphabetical.
+func activeKernel() C.int {
+	kernelInitOnce.Do(func() {
+		kernelMu.Lock()
+		if !kernelForced {
+			selectDefaultKernelLocked()
+		}
+		kernelMu.Unlock()
+	})
+	kernelMu.Lock()
+	k := currentKernel
+	kernelMu.Unlock()
+	return k
+}
+
+// selectDefaultKernelLocked sets currentKernel from the host's CPU
+// features. Callers must hold kernelMu.
+func selectDefaultKernelLocked() {
+	switch {
+	case nativesimd.HasAVX2() && andKernels[kernelAVX2] != nil:
+		currentKernel = kernelAVX2
+	case nativesimd.HasNEON() && andKernels[kernelNEON] != nil:
+		currentKernel = kernelNEON
+	default:
+		currentKernel = kernelScalar
+	}
+}
+
+// activeShiftKernel is activeKernel's counterpart for left_shift_v /
+// right_shift_v. Not every kernel mode has a registered shift kernel (NEON
+// has none - see the comment in simd_arm64.go), so this clamps down to
+// kernelScalar whenever the mode activeKernel() picked has a nil slot in
+// leftShiftKerns/rightShiftKrns, rather than indexing it directly.
+func activeShiftKernel() C.int {
+	k := activeKernel()
+	if leftShiftKerns[k] == nil {
+		return kernelScalar
+	}
+	return k
+}
+
+// pyfunc_set_kernel forces a specific kernel (scalar=0, avx2=1, neon=2) so
+// tests can exercise each code path. The mode is not checked against the
+// host CPU or the kernels registered for this GOARCH: dispatching through a
+// mode that is not available here faults or calls a nil kernel. Pass a negative
+// mode to go back to automatic CPU-feature detection. Safe to call while
+// other threads are mid-dispatch through bitwise_*_v.
+//
+//export pyfunc_set_kernel
+func pyfunc_set_kernel(mode C.int) {
+	kernelInitOnce.Do(func() {})
+	kernelMu.Lock()
+	defer kernelMu.Unlock()
+	if mode < 0 {
+		kernelForced = false
+		selectDefaultKernelLocked()
+		return
+	}
+	kernelForced = true
+	currentKernel = mode
+}
+
+func maskFor(operand C.int) uint64 {
+	u := uint64(uint32(operand))
+	return u | u<<32
+}
+
+func wideWordsAndTail(size C.int) (words int, tailStart int) {
+	n := int(size)
+	words = n / 2
+	tailStart = words * 2
+	return
+}
+
+//export bitwise_and_v
+func bitwise_and_v(data *C.int, size C.int, operand C.int) {
+	words, tail := wideWordsAndTail(size)
+	andKernels[activeKernel()](unsafe.Pointer(data), words, maskFor(operand))
+	for i := tail; i < int(size); i++ {
+		elemPtr(data, i).and(operand)
+	}
+}
+
+//export bitwise_or_v
+func bitwise_or_v(data *C.int, size C.int, operand C.int) {
+	words, tail := wideWordsAndTail(size)
+	orKernels[activeKernel()](unsafe.Pointer(data), words, maskFor(operand))
+	for i := tail; i < int(size); i++ {
+		elemPtr(data, i).or(operand)
+	}
+}
+
+//export bitwise_xor_v
+func bitwise_xor_v(data *C.int, size C.int, operand C.int) {
+	words, tail := wideWordsAndTail(size)
+	xorKernels[activeKernel()](unsafe.Pointer(data), words, maskFor(operand))
+	for i := tail; i < int(size); i++ {
+		elemPtr(data, i).xor(operand)
+	}
+}
+
+//export bitwise_not_v
+func bitwise_not_v(data *C.int, size C.int) {
+	words, tail := wideWordsAndTail(size)
+	notKernels[activeKernel()](unsafe.Pointer(data), words, 0)
+	for i := tail; i < int(size); i++ {
+		elemPtr(data, i).not()
+	}
+}
+
+//export left_shift_v
+func left_shift_v(data *C.int, size C.int, bits C.int) {
+	leftShiftKerns[activeShiftKernel()](unsafe.Pointer(data), int(size), uint32(bits))
+}
+
+//export right_shift_v
+func right_shift_v(data *C.int, size C.int, bits C.int) {
+	rightShiftKrns[activeShiftKernel()](unsafe.Pointer(data), int(size), uint32(bits))
+}
+
+// elemPtr indexes into a *C.int buffer the same way the scalar kernels in
+// bitwise.go do.
+type elem struct {
+	p *C.int
+}
+
+func elemPtr(data *C.int, i int) elem {
+	return elem{p: (*C.int)(unsafe.Pointer(uintptr(unsafe.Pointer(data)) + uintptr(i)*unsafe.Sizeof(*data)))}
+}
+
+func (e elem) and(operand C.int) { *e.p &= operand }
+func (e elem) or(operand C.int)  { *e.p |= operand }
+func (e elem) xor(operand C.int) { *e.p ^= operand }
+func (e elem) not()              { *e.p = ^(*e.p) }
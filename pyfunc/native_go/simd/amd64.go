@@ -0,0 +1,42 @@
+//go:build amd64
+
+package simd
+
+import "unsafe"
+
+// AndAVX2 ANDs mask into words 64-bit words at data using AVX2, with a
+// scalar tail for any remainder.
+//
+//go:noescape
+func AndAVX2(data unsafe.Pointer, words int, mask uint64)
+
+// OrAVX2 ORs mask into words 64-bit words at data using AVX2, with a
+// scalar tail for any remainder.
+//
+//go:noescape
+func OrAVX2(data unsafe.Pointer, words int, mask uint64)
+
+// XorAVX2 XORs mask into words 64-bit words at data using AVX2, with a
+// scalar tail for any remainder.
+//
+//go:noescape
+func XorAVX2(data unsafe.Pointer, words int, mask uint64)
+
+// NotAVX2 inverts words 64-bit words at data using AVX2, with a scalar
+// tail for any remainder. The mask argument is ignored.
+//
+//go:noescape
+func NotAVX2(data unsafe.Pointer, words int, mask uint64)
+
+// LeftShiftAVX2 left-shifts the size int32 lanes at data by bits, using
+// VPSLLD over 8 lanes at a time with a scalar tail for any remainder.
+//
+//go:noescape
+func LeftShiftAVX2(data unsafe.Pointer, size int, bits uint32)
+
+// RightShiftAVX2 arithmetic-right-shifts the size int32 lanes at data by
+// bits (VPSRAD, matching the sign-extending >> the scalar kernels use for
+// C.int), over 8 lanes at a time with a scalar tail for any remainder.
+//
+//go:noescape
+func RightShiftAVX2(data unsafe.Pointer, size int, bits uint32)
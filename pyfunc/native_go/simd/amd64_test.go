@@ -0,0 +1,89 @@
+//go:build amd64
+
+package simd
+
+import (
+	"testing"
+	"unsafe"
+)
+
+// wideKernelCase exercises a wideKernel (AVX2 and its pure-Go reference) at
+// word counts that straddle the AVX2 loop's 4-word chunk boundary: zero
+// words, a single word, and an odd count that leaves a scalar tail.
+func wideKernelCase(t *testing.T, name string, avx2, ref func(unsafe.Pointer, int, uint64), mask uint64) {
+	t.Helper()
+	for _, words := range []int{0, 1, 3, 9} {
+		got := make([]uint64, words+1) // +1 guard word to catch overruns
+		want := make([]uint64, words+1)
+		for i := range got {
+			got[i] = 0x1122334455667788 ^ uint64(i)*0x0101010101010101
+			want[i] = got[i]
+		}
+
+		if words > 0 {
+			avx2(unsafe.Pointer(&got[0]), words, mask)
+			ref(unsafe.Pointer(&want[0]), words, mask)
+		}
+
+		for i := range got {
+			if got[i] != want[i] {
+				t.Errorf("%s words=%d: index %d = %#x, want %#x", name, words, i, got[i], want[i])
+			}
+		}
+	}
+}
+
+func TestAndAVX2MatchesScalar(t *testing.T) {
+	wideKernelCase(t, "AndAVX2", AndAVX2, AndWideGo, 0x00FF00FF00FF00FF)
+}
+
+func TestOrAVX2MatchesScalar(t *testing.T) {
+	wideKernelCase(t, "OrAVX2", OrAVX2, OrWideGo, 0x0F0F0F0F0F0F0F0F)
+}
+
+func TestXorAVX2MatchesScalar(t *testing.T) {
+	wideKernelCase(t, "XorAVX2", XorAVX2, XorWideGo, 0xFFFFFFFF00000000)
+}
+
+func TestNotAVX2MatchesScalar(t *testing.T) {
+	wideKernelCase(t, "NotAVX2", NotAVX2, NotWideGo, 0)
+}
+
+func shiftKernelCase(t *testing.T, name string, avx2, ref func(unsafe.Pointer, int, uint32), bits uint32) {
+	t.Helper()
+	for _, size := range []int{0, 1, 7, 17} {
+		got := make([]int32, size+1)
+		want := make([]int32, size+1)
+		for i := range got {
+			got[i] = int32(i)*7 - 3
+			want[i] = got[i]
+		}
+
+		if size > 0 {
+			avx2(unsafe.Pointer(&got[0]), size, bits)
+			ref(unsafe.Pointer(&want[0]), size, bits)
+		}
+
+		for i := range got {
+			if got[i] != want[i] {
+				t.Errorf("%s size=%d: index %d = %d, want %d", name, size, i, got[i], want[i])
+			}
+		}
+	}
+}
+
+func TestLeftShiftAVX2MatchesScalar(t *testing.T) {
+	shiftKernelCase(t, "LeftShiftAVX2", LeftShiftAVX2, LeftShiftScalarGo, 3)
+}
+
+func TestRightShiftAVX2MatchesScalar(t *testing.T) {
+	shiftKernelCase(t, "RightShiftAVX2", RightShiftAVX2, RightShiftScalarGo, 3)
+}
+
+func TestRightShiftAVX2IsArithmetic(t *testing.T) {
+	lanes := []int32{-8}
+	RightShiftAVX2(unsafe.Pointer(&lanes[0]), len(lanes), 2)
+	if lanes[0] != -2 {
+		t.Errorf("RightShiftAVX2(-8, 2) = %d, want -2", lanes[0])
+	}
+}
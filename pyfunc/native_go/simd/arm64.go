@@ -0,0 +1,29 @@
+//go:build arm64
+
+package simd
+
+import "unsafe"
+
+// AndNEON ANDs mask into words 64-bit words at data using NEON, with a
+// scalar tail for any remainder.
+//
+//go:noescape
+func AndNEON(data unsafe.Pointer, words int, mask uint64)
+
+// OrNEON ORs mask into words 64-bit words at data using NEON, with a
+// scalar tail for any remainder.
+//
+//go:noescape
+func OrNEON(data unsafe.Pointer, words int, mask uint64)
+
+// XorNEON XORs mask into words 64-bit words at data using NEON, with a
+// scalar tail for any remainder.
+//
+//go:noescape
+func XorNEON(data unsafe.Pointer, words int, mask uint64)
+
+// NotNEON inverts words 64-bit words at data using NEON, with a scalar
+// tail for any remainder. The mask argument is ignored.
+//
+//go:noescape
+func NotNEON(data unsafe.Pointer, words int, mask uint64)
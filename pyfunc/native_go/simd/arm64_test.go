@@ -0,0 +1,50 @@
+//go:build arm64
+
+package simd
+
+import (
+	"testing"
+	"unsafe"
+)
+
+// wideKernelCase exercises a wideKernel (NEON and its pure-Go reference) at
+// word counts that straddle the NEON loop's 2-word (16-byte) chunk boundary:
+// zero words, a single word, and an odd count that leaves a scalar tail.
+func wideKernelCase(t *testing.T, name string, neon, ref func(unsafe.Pointer, int, uint64), mask uint64) {
+	t.Helper()
+	for _, words := range []int{0, 1, 3, 9} {
+		got := make([]uint64, words+1) // +1 guard word to catch overruns
+		want := make([]uint64, words+1)
+		for i := range got {
+			got[i] = 0x1122334455667788 ^ uint64(i)*0x0101010101010101
+			want[i] = got[i]
+		}
+
+		if words > 0 {
+			neon(unsafe.Pointer(&got[0]), words, mask)
+			ref(unsafe.Pointer(&want[0]), words, mask)
+		}
+
+		for i := range got {
+			if got[i] != want[i] {
+				t.Errorf("%s words=%d: index %d = %#x, want %#x", name, words, i, got[i], want[i])
+			}
+		}
+	}
+}
+
+func TestAndNEONMatchesScalar(t *testing.T) {
+	wideKernelCase(t, "AndNEON", AndNEON, AndWideGo, 0x00FF00FF00FF00FF)
+}
+
+func TestOrNEONMatchesScalar(t *testing.T) {
+	wideKernelCase(t, "OrNEON", OrNEON, OrWideGo, 0x0F0F0F0F0F0F0F0F)
+}
+
+func TestXorNEONMatchesScalar(t *testing.T) {
+	wideKernelCase(t, "XorNEON", XorNEON, XorWideGo, 0xFFFFFFFF00000000)
+}
+
+func TestNotNEONMatchesScalar(t *testing.T) {
+	wideKernelCase(t, "NotNEON", NotNEON, NotWideGo, 0)
+}
@@ -0,0 +1,77 @@
+// Package simd provides wide-word bitwise kernels with hand-written
+// architecture-specific backends. It is kept cgo-free (unlike the rest of
+// native_go) because the Go toolchain does not allow a package that uses
+// cgo to also contain Go assembly (.s) files; simd.go, simd_amd64.go and
+// simd_arm64.go in native_go import this package instead of inlining the
+// asm themselves.
+package simd
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/cpu"
+)
+
+// HasAVX2 reports whether this CPU supports the AVX2 kernels in this package.
+func HasAVX2() bool { return cpu.X86.HasAVX2 }
+
+// HasNEON reports whether this CPU supports the NEON kernels in this package.
+func HasNEON() bool { return cpu.ARM64.HasASIMD }
+
+func wideSlice(data unsafe.Pointer, words int) []uint64 {
+	return unsafe.Slice((*uint64)(data), words)
+}
+
+// AndWideGo is the pure-Go fallback for AND, available on every GOARCH.
+func AndWideGo(data unsafe.Pointer, words int, mask uint64) {
+	s := wideSlice(data, words)
+	for i := range s {
+		s[i] &= mask
+	}
+}
+
+// OrWideGo is the pure-Go fallback for OR, available on every GOARCH.
+func OrWideGo(data unsafe.Pointer, words int, mask uint64) {
+	s := wideSlice(data, words)
+	for i := range s {
+		s[i] |= mask
+	}
+}
+
+// XorWideGo is the pure-Go fallback for XOR, available on every GOARCH.
+func XorWideGo(data unsafe.Pointer, words int, mask uint64) {
+	s := wideSlice(data, words)
+	for i := range s {
+		s[i] ^= mask
+	}
+}
+
+// NotWideGo is the pure-Go fallback for NOT, available on every GOARCH.
+func NotWideGo(data unsafe.Pointer, words int, _ uint64) {
+	s := wideSlice(data, words)
+	for i := range s {
+		s[i] = ^s[i]
+	}
+}
+
+func int32Slice(data unsafe.Pointer, size int) []int32 {
+	return unsafe.Slice((*int32)(data), size)
+}
+
+// LeftShiftScalarGo is the pure-Go fallback for a left shift over 32-bit
+// lanes, available on every GOARCH.
+func LeftShiftScalarGo(data unsafe.Pointer, size int, bits uint32) {
+	s := int32Slice(data, size)
+	for i := range s {
+		s[i] <<= bits
+	}
+}
+
+// RightShiftScalarGo is the pure-Go fallback for a right shift over 32-bit
+// lanes, available on every GOARCH.
+func RightShiftScalarGo(data unsafe.Pointer, size int, bits uint32) {
+	s := int32Slice(data, size)
+	for i := range s {
+		s[i] >>= bits
+	}
+}
@@ -0,0 +1,70 @@
+package simd
+
+import (
+	"testing"
+	"unsafe"
+)
+
+func TestAndWideGo(t *testing.T) {
+	words := []uint64{0xFFFFFFFFFFFFFFFF, 0x0F0F0F0F0F0F0F0F}
+	AndWideGo(unsafe.Pointer(&words[0]), len(words), 0x00FF00FF00FF00FF)
+	if words[0] != 0x00FF00FF00FF00FF {
+		t.Errorf("words[0] = %#x, want %#x", words[0], uint64(0x00FF00FF00FF00FF))
+	}
+	if words[1] != 0x000F000F000F000F {
+		t.Errorf("words[1] = %#x, want %#x", words[1], uint64(0x000F000F000F000F))
+	}
+}
+
+func TestOrWideGo(t *testing.T) {
+	words := []uint64{0, 0xF0F0F0F0F0F0F0F0}
+	OrWideGo(unsafe.Pointer(&words[0]), len(words), 0x0F0F0F0F0F0F0F0F)
+	if words[0] != 0x0F0F0F0F0F0F0F0F {
+		t.Errorf("words[0] = %#x", words[0])
+	}
+	if words[1] != 0xFFFFFFFFFFFFFFFF {
+		t.Errorf("words[1] = %#x", words[1])
+	}
+}
+
+func TestXorWideGo(t *testing.T) {
+	words := []uint64{0xFFFFFFFFFFFFFFFF}
+	XorWideGo(unsafe.Pointer(&words[0]), len(words), 0xFFFFFFFFFFFFFFFF)
+	if words[0] != 0 {
+		t.Errorf("words[0] = %#x, want 0", words[0])
+	}
+}
+
+func TestNotWideGo(t *testing.T) {
+	words := []uint64{0, 0xFFFFFFFFFFFFFFFF}
+	NotWideGo(unsafe.Pointer(&words[0]), len(words), 0)
+	if words[0] != 0xFFFFFFFFFFFFFFFF || words[1] != 0 {
+		t.Errorf("words = %#x, %#x", words[0], words[1])
+	}
+}
+
+func TestShiftScalarGo(t *testing.T) {
+	lanes := []int32{1, -8}
+	LeftShiftScalarGo(unsafe.Pointer(&lanes[0]), len(lanes), 2)
+	if lanes[0] != 4 || lanes[1] != -32 {
+		t.Errorf("after left shift: %v", lanes)
+	}
+
+	RightShiftScalarGo(unsafe.Pointer(&lanes[0]), len(lanes), 2)
+	if lanes[0] != 1 || lanes[1] != -8 {
+		t.Errorf("after right shift: %v", lanes)
+	}
+}
+
+func TestRightShiftScalarGoIsArithmetic(t *testing.T) {
+	lanes := []int32{-1}
+	RightShiftScalarGo(unsafe.Pointer(&lanes[0]), len(lanes), 1)
+	if lanes[0] != -1 {
+		t.Errorf("arithmetic right shift of -1 should stay -1, got %d", lanes[0])
+	}
+}
+
+func TestHasAVX2AndHasNEONDontPanic(t *testing.T) {
+	_ = HasAVX2()
+	_ = HasNEON()
+}
@@ -0,0 +1,14 @@
+//go:build amd64
+
+package main
+
+import nativesimd "github.com/alexzzzs/PyFunc/pyfunc/native_go/simd"
+
+func init() {
+	andKernels[kernelAVX2] = nativesimd.AndAVX2
+	orKernels[kernelAVX2] = nativesimd.OrAVX2
+	xorKernels[kernelAVX2] = nativesimd.XorAVX2
+	notKernels[kernelAVX2] = nativesimd.NotAVX2
+	leftShiftKerns[kernelAVX2] = nativesimd.LeftShiftAVX2
+	rightShiftKrns[kernelAVX2] = nativesimd.RightShiftAVX2
+}
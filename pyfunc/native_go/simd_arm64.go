@@ -0,0 +1,19 @@
+//go:build arm64
+
+package main
+
+import nativesimd "github.com/alexzzzs/PyFunc/pyfunc/native_go/simd"
+
+func init() {
+	andKernels[kernelNEON] = nativesimd.AndNEON
+	orKernels[kernelNEON] = nativesimd.OrNEON
+	xorKernels[kernelNEON] = nativesimd.XorNEON
+	notKernels[kernelNEON] = nativesimd.NotNEON
+
+	// No NEON shift kernel: Go's arm64 assembler only exposes VSHL/VUSHR
+	// with an immediate shift count, not the variable per-call count
+	// left_shift_v/right_shift_v need, so leftShiftKerns[kernelNEON] and
+	// rightShiftKrns[kernelNEON] stay nil and activeShiftKernel() falls
+	// back to kernelScalar on arm64. Revisit if a future Go release adds
+	// a register-count vector shift mnemonic.
+}
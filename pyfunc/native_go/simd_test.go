@@ -0,0 +1,56 @@
+package main
+
+import (
+	"sync"
+	"testing"
+)
+
+// TestActiveShiftKernelClampsToScalar ensures activeShiftKernel never returns
+// a mode whose leftShiftKerns/rightShiftKrns slot is nil, even when
+// activeKernel() itself would pick that mode (e.g. NEON, which registers
+// AND/OR/XOR/NOT but has no shift kernel - see simd_arm64.go).
+func TestActiveShiftKernelClampsToScalar(t *testing.T) {
+	saved := leftShiftKerns[kernelNEON]
+	leftShiftKerns[kernelNEON] = nil
+	defer func() { leftShiftKerns[kernelNEON] = saved }()
+
+	pyfunc_set_kernel(kernelNEON)
+	defer pyfunc_set_kernel(-1)
+
+	if got := activeShiftKernel(); got != kernelScalar {
+		t.Errorf("activeShiftKernel() = %d, want kernelScalar (%d)", got, kernelScalar)
+	}
+}
+
+// TestActiveShiftKernelUsesAVX2 checks the non-clamped path: once AVX2 shift
+// kernels are registered (simd_amd64.go), forcing AVX2 should dispatch to it
+// rather than falling back to scalar.
+func TestActiveShiftKernelUsesAVX2(t *testing.T) {
+	if leftShiftKerns[kernelAVX2] == nil {
+		t.Skip("no AVX2 shift kernel registered on this arch")
+	}
+
+	pyfunc_set_kernel(kernelAVX2)
+	defer pyfunc_set_kernel(-1)
+
+	if got := activeShiftKernel(); got != kernelAVX2 {
+		t.Errorf("activeShiftKernel() = %d, want kernelAVX2 (%d)", got, kernelAVX2)
+	}
+}
+
+// TestPyfuncSetKernelConcurrent exercises pyfunc_set_kernel and activeKernel
+// from multiple goroutines at once, per chunk0-4's requirement that
+// //export'ed functions be safe to call concurrently from multiple Python
+// threads. Run with -race to catch regressions in the kernelMu locking.
+func TestPyfuncSetKernelConcurrent(t *testing.T) {
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(4)
+		go func() { defer wg.Done(); pyfunc_set_kernel(kernelScalar) }()
+		go func() { defer wg.Done(); pyfunc_set_kernel(kernelAVX2) }()
+		go func() { defer wg.Done(); pyfunc_set_kernel(kernelNEON) }()
+		go func() { defer wg.Done(); _ = activeKernel() }()
+	}
+	wg.Wait()
+	pyfunc_set_kernel(-1)
+}